@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/centrifugal/centrifuge"
@@ -56,7 +57,7 @@ func (h *apiExecutor) Publish(ctx context.Context, cmd *PublishRequest) *Publish
 		pub.UID = cmd.UID
 	}
 
-	err := <-h.node.PublishAsync(cmd.Channel, pub)
+	err := h.node.Publish(cmd.Channel, pub)
 	if err != nil {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error publishing message in engine", map[string]interface{}{"error": err.Error()}))
 		resp.Error = ErrorInternal
@@ -88,9 +89,7 @@ func (h *apiExecutor) Broadcast(ctx context.Context, cmd *BroadcastRequest) *Bro
 		return resp
 	}
 
-	errs := make([]<-chan error, len(channels))
-
-	for i, ch := range channels {
+	for _, ch := range channels {
 
 		if ch == "" {
 			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "channel can not be blank in broadcast", nil))
@@ -104,19 +103,18 @@ func (h *apiExecutor) Broadcast(ctx context.Context, cmd *BroadcastRequest) *Bro
 			resp.Error = ErrorNamespaceNotFound
 			return resp
 		}
+	}
 
-		pub := &centrifuge.Publication{
-			Data: centrifuge.Raw(cmd.Data),
-		}
-		if cmd.UID != "" {
-			pub.UID = cmd.UID
-		}
-		errs[i] = h.node.PublishAsync(ch, pub)
+	pub := &centrifuge.Publication{
+		Data: centrifuge.Raw(cmd.Data),
 	}
+	if cmd.UID != "" {
+		pub.UID = cmd.UID
+	}
+	errs := h.node.PublishToChannels(channels, pub, nil)
 
 	var firstErr error
-	for i := range errs {
-		err := <-errs[i]
+	for i, err := range errs {
 		if err != nil {
 			if firstErr == nil {
 				firstErr = err
@@ -219,7 +217,9 @@ func (h *apiExecutor) Presence(ctx context.Context, cmd *PresenceRequest) *Prese
 	}
 
 	presence, err := h.node.Presence(ch)
-	if err != nil {
+	if err == centrifuge.ErrPresencePartial {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "presence collected from part of nodes", map[string]interface{}{"channel": ch}))
+	} else if err != nil {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error calling presence", map[string]interface{}{"error": err.Error()}))
 		resp.Error = ErrorInternal
 		return resp
@@ -268,7 +268,9 @@ func (h *apiExecutor) PresenceStats(ctx context.Context, cmd *PresenceStatsReque
 	}
 
 	stats, err := h.node.PresenceStats(cmd.Channel)
-	if err != nil {
+	if err == centrifuge.ErrPresencePartial {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "presence stats collected from part of nodes", map[string]interface{}{"channel": ch}))
+	} else if err != nil {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error calling presence stats", map[string]interface{}{"error": err.Error()}))
 		resp.Error = ErrorInternal
 		return resp
@@ -431,7 +433,38 @@ func (h *apiExecutor) Info(ctx context.Context, cmd *InfoRequest) *InfoResponse
 		nodes[i] = res
 	}
 	resp.Result = &InfoResult{
-		Nodes: nodes,
+		Nodes:  nodes,
+		Totals: infoTotals(nodes),
 	}
 	return resp
 }
+
+// infoTotals sums node values over all nodes. Users and channels present
+// on several nodes counted once per node. Gauge and counter metrics are
+// summed, summary quantiles can't be summed so they are left out.
+func infoTotals(nodes []*NodeResult) *InfoTotals {
+	totals := &InfoTotals{
+		NumNodes: uint32(len(nodes)),
+	}
+	for _, nd := range nodes {
+		totals.NumClients += nd.NumClients
+		totals.NumUsers += nd.NumUsers
+		totals.NumChannels += nd.NumChannels
+		if nd.Metrics == nil {
+			continue
+		}
+		if totals.Metrics == nil {
+			totals.Metrics = &Metrics{
+				Interval: nd.Metrics.Interval,
+				Items:    make(map[string]float64),
+			}
+		}
+		for name, value := range nd.Metrics.Items {
+			if strings.Contains(name, ".quantile.") {
+				continue
+			}
+			totals.Metrics.Items[name] += value
+		}
+	}
+	return totals
+}
@@ -45,6 +45,7 @@
 		InfoResult
 		NodeResult
 		Metrics
+		InfoTotals
 */
 package api
 
@@ -764,7 +765,8 @@ func (m *InfoResponse) GetResult() *InfoResult {
 }
 
 type InfoResult struct {
-	Nodes []*NodeResult `protobuf:"bytes,1,rep,name=nodes" json:"nodes"`
+	Nodes  []*NodeResult `protobuf:"bytes,1,rep,name=nodes" json:"nodes"`
+	Totals *InfoTotals   `protobuf:"bytes,2,opt,name=totals" json:"totals"`
 }
 
 func (m *InfoResult) Reset()                    { *m = InfoResult{} }
@@ -779,6 +781,13 @@ func (m *InfoResult) GetNodes() []*NodeResult {
 	return nil
 }
 
+func (m *InfoResult) GetTotals() *InfoTotals {
+	if m != nil {
+		return m.Totals
+	}
+	return nil
+}
+
 type NodeResult struct {
 	UID         string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
 	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
@@ -875,6 +884,54 @@ func (m *Metrics) GetItems() map[string]float64 {
 	return nil
 }
 
+type InfoTotals struct {
+	NumNodes    uint32   `protobuf:"varint,1,opt,name=num_nodes,json=numNodes,proto3" json:"num_nodes"`
+	NumClients  uint32   `protobuf:"varint,2,opt,name=num_clients,json=numClients,proto3" json:"num_clients"`
+	NumUsers    uint32   `protobuf:"varint,3,opt,name=num_users,json=numUsers,proto3" json:"num_users"`
+	NumChannels uint32   `protobuf:"varint,4,opt,name=num_channels,json=numChannels,proto3" json:"num_channels"`
+	Metrics     *Metrics `protobuf:"bytes,5,opt,name=metrics" json:"metrics"`
+}
+
+func (m *InfoTotals) Reset()                    { *m = InfoTotals{} }
+func (m *InfoTotals) String() string            { return proto.CompactTextString(m) }
+func (*InfoTotals) ProtoMessage()               {}
+func (*InfoTotals) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{37} }
+
+func (m *InfoTotals) GetNumNodes() uint32 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
+
+func (m *InfoTotals) GetNumClients() uint32 {
+	if m != nil {
+		return m.NumClients
+	}
+	return 0
+}
+
+func (m *InfoTotals) GetNumUsers() uint32 {
+	if m != nil {
+		return m.NumUsers
+	}
+	return 0
+}
+
+func (m *InfoTotals) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
+}
+
+func (m *InfoTotals) GetMetrics() *Metrics {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClientInfo)(nil), "api.ClientInfo")
 	proto.RegisterType((*Publication)(nil), "api.Publication")
@@ -913,6 +970,7 @@ func init() {
 	proto.RegisterType((*InfoResult)(nil), "api.InfoResult")
 	proto.RegisterType((*NodeResult)(nil), "api.NodeResult")
 	proto.RegisterType((*Metrics)(nil), "api.Metrics")
+	proto.RegisterType((*InfoTotals)(nil), "api.InfoTotals")
 	proto.RegisterEnum("api.MethodType", MethodType_name, MethodType_value)
 }
 func (this *ClientInfo) Equal(that interface{}) bool {
@@ -1835,6 +1893,9 @@ func (this *InfoResult) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Totals.Equal(that1.Totals) {
+		return false
+	}
 	return true
 }
 func (this *NodeResult) Equal(that interface{}) bool {
@@ -1914,6 +1975,42 @@ func (this *Metrics) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *InfoTotals) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InfoTotals)
+	if !ok {
+		that2, ok := that.(InfoTotals)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumNodes != that1.NumNodes {
+		return false
+	}
+	if this.NumClients != that1.NumClients {
+		return false
+	}
+	if this.NumUsers != that1.NumUsers {
+		return false
+	}
+	if this.NumChannels != that1.NumChannels {
+		return false
+	}
+	if !this.Metrics.Equal(that1.Metrics) {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -3383,6 +3480,16 @@ func (m *InfoResult) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Totals != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Totals.Size()))
+		n31, err := m.Totals.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
 	return i, nil
 }
 
@@ -3443,11 +3550,11 @@ func (m *NodeResult) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintApi(dAtA, i, uint64(m.Metrics.Size()))
-		n31, err := m.Metrics.MarshalTo(dAtA[i:])
+		n32, err := m.Metrics.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n32
 	}
 	return i, nil
 }
@@ -3493,6 +3600,54 @@ func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *InfoTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InfoTotals) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NumNodes != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.NumNodes))
+	}
+	if m.NumClients != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.NumClients))
+	}
+	if m.NumUsers != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.NumUsers))
+	}
+	if m.NumChannels != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.NumChannels))
+	}
+	if m.Metrics != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApi(dAtA, i, uint64(m.Metrics.Size()))
+		n33, err := m.Metrics.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	return i, nil
+}
+
 func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -3873,6 +4028,9 @@ func NewPopulatedInfoResult(r randyApi, easy bool) *InfoResult {
 			this.Nodes[i] = NewPopulatedNodeResult(r, easy)
 		}
 	}
+	if r.Intn(10) != 0 {
+		this.Totals = NewPopulatedInfoTotals(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3917,6 +4075,20 @@ func NewPopulatedMetrics(r randyApi, easy bool) *Metrics {
 	return this
 }
 
+func NewPopulatedInfoTotals(r randyApi, easy bool) *InfoTotals {
+	this := &InfoTotals{}
+	this.NumNodes = uint32(r.Uint32())
+	this.NumClients = uint32(r.Uint32())
+	this.NumUsers = uint32(r.Uint32())
+	this.NumChannels = uint32(r.Uint32())
+	if r.Intn(10) != 0 {
+		this.Metrics = NewPopulatedMetrics(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyApi interface {
 	Float32() float32
 	Float64() float64
@@ -4409,6 +4581,10 @@ func (m *InfoResult) Size() (n int) {
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
+	if m.Totals != nil {
+		l = m.Totals.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
@@ -4463,6 +4639,28 @@ func (m *Metrics) Size() (n int) {
 	return n
 }
 
+func (m *InfoTotals) Size() (n int) {
+	var l int
+	_ = l
+	if m.NumNodes != 0 {
+		n += 1 + sovApi(uint64(m.NumNodes))
+	}
+	if m.NumClients != 0 {
+		n += 1 + sovApi(uint64(m.NumClients))
+	}
+	if m.NumUsers != 0 {
+		n += 1 + sovApi(uint64(m.NumUsers))
+	}
+	if m.NumChannels != 0 {
+		n += 1 + sovApi(uint64(m.NumChannels))
+	}
+	if m.Metrics != nil {
+		l = m.Metrics.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
 func sovApi(x uint64) (n int) {
 	for {
 		n++
@@ -7904,6 +8102,39 @@ func (m *InfoResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Totals == nil {
+				m.Totals = &InfoTotals{}
+			}
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -8332,6 +8563,165 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InfoTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfoTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfoTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
+			}
+			m.NumNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNodes |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumClients", wireType)
+			}
+			m.NumClients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumClients |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUsers", wireType)
+			}
+			m.NumUsers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumUsers |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumChannels", wireType)
+			}
+			m.NumChannels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumChannels |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metrics == nil {
+				m.Metrics = &Metrics{}
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApi(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8440,109 +8830,112 @@ var (
 func init() { proto.RegisterFile("api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 1654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0x51,
-	0x11, 0xcf, 0xfa, 0x23, 0xb6, 0xc7, 0x1f, 0xd9, 0x3c, 0xdb, 0x89, 0x6b, 0x22, 0xaf, 0x59, 0xd1,
-	0x12, 0x45, 0x34, 0x2d, 0x89, 0x68, 0x43, 0x45, 0x29, 0x59, 0xc7, 0x55, 0x0c, 0xa9, 0x13, 0x3d,
-	0x27, 0x48, 0x15, 0x87, 0x68, 0x63, 0x6f, 0x92, 0x15, 0xf1, 0xae, 0xd9, 0x8f, 0xa0, 0x5c, 0x11,
-	0x12, 0xc8, 0x20, 0x54, 0x71, 0xe0, 0x82, 0x22, 0x0e, 0x20, 0x81, 0xc4, 0x3f, 0xc0, 0x9f, 0xd0,
-	0x63, 0xcf, 0x1c, 0x16, 0x08, 0x37, 0xff, 0x05, 0x3d, 0xa2, 0xf7, 0xde, 0x7e, 0xc7, 0xd4, 0x0d,
-	0xa1, 0x17, 0xef, 0x7b, 0xf3, 0x66, 0x66, 0x67, 0x7e, 0x33, 0x3b, 0x33, 0xcf, 0x90, 0x93, 0x47,
-	0xea, 0xfa, 0xc8, 0xd0, 0x2d, 0x1d, 0x25, 0xe5, 0x91, 0x5a, 0x7f, 0x7c, 0xa6, 0x5a, 0xe7, 0xf6,
-	0xc9, 0x7a, 0x5f, 0x1f, 0x3e, 0x39, 0xd3, 0xcf, 0xf4, 0x27, 0xf4, 0xec, 0xc4, 0x3e, 0xa5, 0x3b,
-	0xba, 0xa1, 0x2b, 0x26, 0x23, 0x7e, 0xe0, 0x00, 0x5a, 0x17, 0xaa, 0xa2, 0x59, 0x1d, 0xed, 0x54,
-	0x47, 0x2b, 0x90, 0xb2, 0x4d, 0xc5, 0xa8, 0x71, 0x4d, 0x6e, 0x35, 0x27, 0x65, 0x27, 0x8e, 0x40,
-	0xf7, 0x98, 0xfe, 0x22, 0x11, 0xe6, 0xfb, 0x94, 0xb7, 0x96, 0xa0, 0xe7, 0x30, 0x71, 0x04, 0x97,
-	0x82, 0xdd, 0x27, 0x7a, 0x05, 0xb9, 0xbe, 0xae, 0x69, 0xc7, 0xaa, 0x76, 0xaa, 0xd7, 0x92, 0x4d,
-	0x6e, 0xb5, 0x20, 0x89, 0xef, 0x1d, 0x61, 0xee, 0xef, 0x8e, 0x90, 0xc4, 0xf2, 0x4f, 0x27, 0x8e,
-	0x50, 0xf6, 0xcf, 0xbf, 0xa1, 0x0f, 0x55, 0x4b, 0x19, 0x8e, 0xac, 0x2b, 0x9c, 0x25, 0x44, 0x6a,
-	0x02, 0x51, 0x70, 0x2e, 0xbb, 0x0a, 0x52, 0xd3, 0x15, 0x78, 0xe7, 0x11, 0x05, 0xe7, 0x32, 0x55,
-	0x20, 0xfe, 0x9e, 0x83, 0xfc, 0x81, 0x7d, 0x72, 0xa1, 0xf6, 0x65, 0x4b, 0xd5, 0x35, 0xb4, 0x06,
-	0x49, 0x5b, 0x1d, 0xb8, 0x2e, 0xd5, 0x6e, 0x1c, 0x21, 0x79, 0xd4, 0xd9, 0x99, 0x38, 0x42, 0xd1,
-	0x56, 0x07, 0x21, 0x05, 0x84, 0x09, 0x7d, 0x1d, 0x52, 0x03, 0xd9, 0x92, 0xa9, 0x7f, 0x05, 0xa9,
-	0x1c, 0x7d, 0x2f, 0x3d, 0xc2, 0xf4, 0x17, 0x3d, 0x87, 0x94, 0xef, 0x61, 0x7e, 0x63, 0x61, 0x9d,
-	0x44, 0x21, 0xc0, 0x51, 0x42, 0x13, 0x47, 0x28, 0xc5, 0x2c, 0xa4, 0x02, 0xe2, 0x1e, 0xa4, 0xdb,
-	0x86, 0xa1, 0x1b, 0x04, 0xea, 0xbe, 0x3e, 0x50, 0xa8, 0x5d, 0x45, 0x06, 0x35, 0xd9, 0x63, 0xfa,
-	0x8b, 0x1e, 0x42, 0x66, 0xa8, 0x98, 0xa6, 0x7c, 0xa6, 0xb8, 0x58, 0xe7, 0x27, 0x8e, 0xe0, 0x91,
-	0xb0, 0xb7, 0x10, 0x7f, 0xc5, 0x41, 0xa6, 0xa5, 0x0f, 0x87, 0xb2, 0x36, 0x40, 0x2b, 0x90, 0x70,
-	0xdd, 0x2c, 0x4a, 0x85, 0x1b, 0x47, 0x48, 0x50, 0x2f, 0x13, 0xea, 0x00, 0x27, 0xd4, 0x01, 0xda,
-	0x84, 0xf9, 0xa1, 0x62, 0x9d, 0xeb, 0x03, 0xaa, 0xaf, 0xe4, 0x9a, 0xfc, 0x86, 0x92, 0x0e, 0xaf,
-	0x46, 0x0a, 0x0b, 0x26, 0x63, 0xc1, 0xee, 0x13, 0x3d, 0x86, 0xf9, 0x91, 0x6c, 0xc8, 0x43, 0xd3,
-	0x8d, 0x64, 0x35, 0x0a, 0x88, 0x7b, 0x88, 0xdd, 0xa7, 0xf8, 0x07, 0x0e, 0xd2, 0x58, 0x19, 0x5d,
-	0x5c, 0xa1, 0x47, 0x21, 0x5b, 0x96, 0x7c, 0x5b, 0x0a, 0x11, 0xc0, 0x89, 0x55, 0xdf, 0x82, 0xb4,
-	0x42, 0xd0, 0xa0, 0x46, 0xe5, 0x37, 0x80, 0x1a, 0x45, 0xf1, 0x91, 0xca, 0x13, 0x47, 0x58, 0xa0,
-	0x87, 0x21, 0x19, 0xc6, 0x8d, 0x9e, 0xc3, 0xbc, 0xa1, 0x98, 0xf6, 0x85, 0xe5, 0xda, 0x25, 0x44,
-	0xed, 0xe2, 0xd9, 0x61, 0x48, 0xce, 0x65, 0x17, 0x7f, 0xc6, 0x41, 0x89, 0xe6, 0x86, 0x79, 0x8e,
-	0x95, 0x9f, 0xd8, 0x8a, 0x69, 0x11, 0xa4, 0x49, 0xea, 0x68, 0xca, 0x85, 0x9b, 0x22, 0x14, 0x69,
-	0x97, 0x84, 0xbd, 0xc5, 0xe7, 0x67, 0x46, 0x93, 0xa5, 0x5b, 0x92, 0xea, 0x2a, 0x05, 0xe9, 0x46,
-	0xa8, 0x34, 0xc9, 0xc4, 0x31, 0x07, 0x0b, 0xbe, 0x11, 0xe6, 0x48, 0xd7, 0x4c, 0x25, 0x00, 0x82,
-	0xbb, 0x13, 0x10, 0xdf, 0xf3, 0x81, 0x60, 0x00, 0x22, 0x2a, 0x17, 0x28, 0xb7, 0x2f, 0x2c, 0xa9,
-	0xf2, 0x49, 0x44, 0x16, 0xa0, 0x18, 0x61, 0x17, 0x7f, 0xc1, 0x01, 0x2f, 0x19, 0xba, 0x3c, 0xe8,
-	0xcb, 0xa6, 0xe5, 0x81, 0xb4, 0x0a, 0x59, 0x17, 0x08, 0xb3, 0xc6, 0x35, 0x93, 0xab, 0x39, 0xa9,
-	0x30, 0x71, 0x04, 0x9f, 0x86, 0xfd, 0xd5, 0xff, 0x13, 0xa7, 0xdf, 0x70, 0xb0, 0x18, 0xb2, 0xe4,
-	0x7e, 0x48, 0x49, 0x31, 0xa4, 0x2a, 0x54, 0x2e, 0xac, 0x7e, 0x36, 0x56, 0x8b, 0xb0, 0x10, 0x13,
-	0x10, 0xdf, 0x02, 0x3a, 0xd2, 0x4c, 0xfb, 0xc4, 0xec, 0x1b, 0xea, 0x89, 0x72, 0xc7, 0x9c, 0xf2,
-	0xaa, 0x6d, 0x62, 0x5a, 0xb5, 0x15, 0x7f, 0xcb, 0x41, 0x39, 0xa2, 0xfb, 0x7e, 0x00, 0xec, 0xc4,
-	0x00, 0x58, 0xa2, 0x72, 0xd1, 0x17, 0xcc, 0x86, 0xa0, 0x0c, 0x8b, 0xb7, 0x44, 0xc4, 0x6f, 0xc2,
-	0xe2, 0x8e, 0x6a, 0x92, 0x0a, 0xae, 0xf4, 0xfd, 0x94, 0xf9, 0x64, 0x2b, 0x11, 0xdf, 0x71, 0x80,
-	0xc2, 0x32, 0xf7, 0xf3, 0xad, 0x15, 0xf3, 0xad, 0x4a, 0xe5, 0x22, 0xfa, 0x67, 0xbb, 0x86, 0x80,
-	0x8f, 0x4b, 0x88, 0x5b, 0xb0, 0x70, 0x60, 0x28, 0xa6, 0xa2, 0xf5, 0xef, 0x18, 0x5b, 0xf1, 0xd7,
-	0x1c, 0xf0, 0x81, 0xe8, 0xfd, 0xdc, 0xdb, 0x8e, 0xb9, 0x57, 0x66, 0x5f, 0x79, 0xa0, 0x7d, 0xb6,
-	0x73, 0x7f, 0x25, 0x85, 0x2f, 0x22, 0x80, 0x7e, 0x00, 0xd9, 0x91, 0x4b, 0xa1, 0xdf, 0x74, 0x7e,
-	0xe3, 0xab, 0x53, 0xf4, 0xfa, 0xdb, 0xb6, 0x66, 0x19, 0x57, 0xec, 0xb3, 0xf7, 0xc4, 0xb0, 0xbf,
-	0xaa, 0xef, 0x41, 0x31, 0xc2, 0x88, 0x78, 0x48, 0xfe, 0x58, 0xb9, 0x62, 0x10, 0x61, 0xb2, 0x44,
-	0x0f, 0x21, 0x7d, 0x29, 0x5f, 0xd8, 0x8a, 0xeb, 0x44, 0xbc, 0x67, 0x62, 0x76, 0xfa, 0x22, 0xb1,
-	0xc5, 0x89, 0x2f, 0xa1, 0xe2, 0x69, 0xeb, 0x59, 0xb2, 0x65, 0xde, 0x11, 0xfb, 0xdf, 0x71, 0x50,
-	0x8d, 0xc9, 0xdf, 0x2f, 0x00, 0xaf, 0x63, 0x01, 0xa8, 0x45, 0x80, 0xf2, 0x5e, 0x31, 0x3b, 0x0a,
-	0x26, 0x94, 0xa7, 0x08, 0xa1, 0xa7, 0x90, 0xd7, 0xec, 0xe1, 0x31, 0x9b, 0xa0, 0x4c, 0xb7, 0x6d,
-	0x2e, 0x4c, 0x1c, 0x21, 0x4c, 0xc6, 0xa0, 0xd9, 0x43, 0x06, 0x97, 0x89, 0xd6, 0x20, 0x47, 0x8e,
-	0xc8, 0xa7, 0x64, 0x52, 0x9b, 0x8a, 0x52, 0x71, 0xe2, 0x08, 0x01, 0x11, 0x67, 0x35, 0x7b, 0x78,
-	0x44, 0x56, 0xe2, 0x73, 0x28, 0xed, 0xaa, 0xa6, 0xa5, 0x1b, 0x57, 0x77, 0x84, 0x91, 0xf4, 0x29,
-	0x5f, 0xf2, 0x4b, 0xf4, 0xa9, 0x40, 0xf9, 0x6c, 0xe8, 0x7e, 0x04, 0xc5, 0x08, 0x3b, 0xfa, 0x3e,
-	0x14, 0x46, 0xc1, 0x94, 0x67, 0xba, 0x29, 0xcc, 0x07, 0x0d, 0x90, 0x1d, 0x48, 0x95, 0xf7, 0x8e,
-	0xc0, 0x91, 0xe1, 0x23, 0xcc, 0x8d, 0x23, 0x3b, 0x92, 0x6f, 0xbe, 0xf2, 0xa1, 0x7e, 0xa9, 0xfc,
-	0x0f, 0xf9, 0x16, 0x93, 0xff, 0x12, 0xf9, 0x16, 0x7f, 0xc5, 0x6c, 0xd0, 0xaa, 0x50, 0x9e, 0x22,
-	0x44, 0xfa, 0x58, 0xcb, 0xeb, 0xdc, 0xcc, 0x53, 0x5a, 0xae, 0x02, 0xda, 0x97, 0x28, 0x57, 0x21,
-	0xed, 0xb3, 0x0d, 0x7f, 0x01, 0xa5, 0x28, 0xff, 0xe7, 0x4f, 0x20, 0x62, 0x11, 0xf2, 0xb4, 0x9e,
-	0xb8, 0x9e, 0xfd, 0x9c, 0x83, 0x02, 0xdb, 0xdf, 0xcf, 0xab, 0x97, 0x31, 0xaf, 0x58, 0xfd, 0x72,
-	0x35, 0xcf, 0xf6, 0xe8, 0xbb, 0x00, 0x01, 0x2f, 0x7a, 0x0a, 0x69, 0x4d, 0x1f, 0x28, 0x5e, 0xd6,
-	0x32, 0x5d, 0x5d, 0x32, 0xfe, 0x33, 0x5d, 0xb9, 0x89, 0x23, 0x30, 0x0e, 0xcc, 0x1e, 0xe2, 0x3f,
-	0x12, 0x00, 0x01, 0x83, 0x37, 0x3d, 0x71, 0xff, 0x75, 0x7a, 0x22, 0xfd, 0x57, 0x93, 0x87, 0x4a,
-	0x78, 0xb8, 0x20, 0x7b, 0x4c, 0x7f, 0x49, 0x66, 0x5f, 0x2a, 0x86, 0xa9, 0xea, 0x9a, 0x3b, 0x81,
-	0xd1, 0xcc, 0x76, 0x49, 0xd8, 0x5b, 0xc4, 0x2b, 0x53, 0xea, 0x8e, 0x95, 0x29, 0xfd, 0xc9, 0xca,
-	0x84, 0x36, 0xa1, 0x40, 0xd5, 0x78, 0x71, 0x9d, 0xa7, 0xec, 0x3c, 0xf9, 0x58, 0xc3, 0x74, 0x4c,
-	0x5e, 0xe6, 0xa5, 0x03, 0xb9, 0x84, 0xda, 0x23, 0x4b, 0x1d, 0x2a, 0xb5, 0x0c, 0x65, 0xa7, 0xf7,
-	0x16, 0x46, 0xc1, 0xee, 0x13, 0x6d, 0x92, 0xdb, 0x93, 0x65, 0xa8, 0x7d, 0xb3, 0x96, 0xa5, 0xc1,
-	0x2a, 0x78, 0xb7, 0x1d, 0x42, 0xf3, 0xee, 0x52, 0x74, 0x83, 0xbd, 0x85, 0xf8, 0x67, 0x0e, 0x32,
-	0x2e, 0x07, 0xc9, 0x36, 0x55, 0xb3, 0x14, 0xe3, 0x52, 0x66, 0x5f, 0x3e, 0xc7, 0xb2, 0xcd, 0xa3,
-	0x61, 0x7f, 0x85, 0xb6, 0x20, 0x4d, 0x42, 0x4d, 0xaa, 0x30, 0x89, 0xe4, 0x72, 0xf8, 0x45, 0xeb,
-	0x1d, 0x72, 0xc2, 0x1a, 0x27, 0x8d, 0x28, 0xe5, 0xc4, 0xec, 0x51, 0xdf, 0x02, 0x08, 0xce, 0xa7,
-	0xf4, 0xcb, 0x4a, 0xb8, 0x5f, 0x72, 0xa1, 0xf6, 0xb8, 0xf6, 0xa7, 0x24, 0x40, 0x70, 0x73, 0x43,
-	0x22, 0x64, 0x0e, 0x8e, 0xa4, 0xbd, 0x4e, 0x6f, 0x97, 0x9f, 0xab, 0x57, 0xc7, 0xd7, 0xcd, 0xc5,
-	0xe0, 0xd0, 0x9d, 0xed, 0xd1, 0x23, 0xc8, 0x49, 0x78, 0x7f, 0x7b, 0xa7, 0xb5, 0xdd, 0x3b, 0xe4,
-	0xb9, 0xfa, 0xf2, 0xf8, 0xba, 0x59, 0x0e, 0x5d, 0xfe, 0xbc, 0xa9, 0x16, 0xad, 0x41, 0xfe, 0xa8,
-	0xdb, 0x3b, 0x92, 0x7a, 0x2d, 0xdc, 0x91, 0xda, 0x7c, 0xa2, 0xfe, 0x60, 0x7c, 0xdd, 0xac, 0x06,
-	0x9c, 0xa1, 0xe1, 0x0f, 0xad, 0x02, 0xec, 0x74, 0x7a, 0xad, 0xfd, 0x6e, 0xb7, 0xdd, 0x3a, 0xe4,
-	0x93, 0xf5, 0xda, 0xf8, 0xba, 0x59, 0x09, 0x58, 0x83, 0x61, 0x0a, 0x7d, 0x0d, 0xb2, 0x07, 0xb8,
-	0xdd, 0x6b, 0x77, 0x5b, 0x6d, 0x3e, 0x55, 0x5f, 0x1a, 0x5f, 0x37, 0x51, 0xc8, 0x44, 0xb7, 0x23,
-	0xa2, 0x27, 0x50, 0xf2, 0xb8, 0x8e, 0x7b, 0x87, 0xdb, 0x87, 0x3d, 0x3e, 0x5d, 0xff, 0xca, 0xf8,
-	0xba, 0xb9, 0x7c, 0x9b, 0x97, 0x76, 0x4f, 0xe2, 0xf8, 0x6e, 0xa7, 0x77, 0xb8, 0x8f, 0xdf, 0xf2,
-	0xf3, 0x71, 0xc7, 0xdd, 0xba, 0x47, 0x94, 0xba, 0x3c, 0xc7, 0xb8, 0xfd, 0x66, 0xff, 0x87, 0x6d,
-	0x3e, 0x13, 0x57, 0x1a, 0x29, 0x91, 0xc4, 0xd6, 0xd6, 0xee, 0x76, 0xb7, 0xdb, 0xde, 0xeb, 0xf1,
-	0xd9, 0xb8, 0xad, 0x7e, 0x16, 0xae, 0x40, 0xaa, 0xd3, 0x7d, 0xbd, 0xcf, 0xe7, 0xea, 0x68, 0x7c,
-	0xdd, 0x2c, 0x05, 0x1c, 0xe4, 0x23, 0xaf, 0xa7, 0x7e, 0xf9, 0xc7, 0xc6, 0xdc, 0xc6, 0xc7, 0x14,
-	0x40, 0x4b, 0xd1, 0x2c, 0x43, 0x3d, 0xb5, 0xcf, 0x14, 0xf4, 0x0c, 0x32, 0x5e, 0x34, 0xca, 0xd1,
-	0x6b, 0x1a, 0x2d, 0x54, 0xf5, 0x4a, 0xec, 0xee, 0x46, 0xab, 0x95, 0x38, 0x87, 0xbe, 0x03, 0xb9,
-	0x20, 0x3e, 0xd5, 0xf8, 0xb5, 0x85, 0xc9, 0x2e, 0xdd, 0xba, 0xcd, 0x78, 0xd2, 0x12, 0xe4, 0xc3,
-	0x31, 0x5b, 0xbe, 0x3d, 0xf5, 0x33, 0x0d, 0xb5, 0x29, 0xd7, 0x01, 0x4f, 0xc7, 0x2b, 0x80, 0x50,
-	0x30, 0x97, 0x6e, 0x0d, 0xd7, 0x4c, 0xc3, 0xf2, 0xed, 0xa1, 0xdb, 0x53, 0xf0, 0x6d, 0xc8, 0xfa,
-	0x51, 0xae, 0xc4, 0x86, 0x4c, 0x26, 0x5c, 0x8d, 0x8f, 0x9e, 0x9e, 0xe8, 0x6e, 0x30, 0x58, 0xb2,
-	0xa0, 0x3f, 0x98, 0x36, 0x7b, 0x31, 0x25, 0xf5, 0xa9, 0x63, 0x99, 0xa7, 0xe9, 0x19, 0x64, 0xbc,
-	0xa4, 0x28, 0x47, 0xfb, 0x69, 0x18, 0xff, 0xd8, 0xc0, 0xc3, 0x2c, 0x88, 0x66, 0xc8, 0x83, 0x69,
-	0xdd, 0x38, 0x6c, 0xc1, 0xd4, 0x59, 0x80, 0xc1, 0xe0, 0x27, 0x50, 0x25, 0xd6, 0x14, 0xc3, 0x30,
-	0xc4, 0x1b, 0xb1, 0x38, 0x87, 0x1e, 0x43, 0x8a, 0xfe, 0x3b, 0xc6, 0x87, 0xba, 0x0e, 0x13, 0x59,
-	0x0c, 0xf7, 0x21, 0x97, 0x5d, 0x5a, 0xf9, 0xf8, 0xaf, 0x06, 0xf7, 0x97, 0x9b, 0x06, 0xf7, 0xb7,
-	0x9b, 0x06, 0xf7, 0xfe, 0xa6, 0xc1, 0x7d, 0xb8, 0x69, 0x70, 0xff, 0xbc, 0x69, 0x70, 0xef, 0xfe,
-	0xdd, 0x98, 0x3b, 0x99, 0xa7, 0xff, 0xfd, 0x6d, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x82,
-	0xa2, 0xdd, 0x3c, 0x14, 0x00, 0x00,
+	// 1702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0xf9, 0x23, 0xb6, 0x9f, 0x3f, 0xd2, 0x29, 0xdb, 0x89, 0xc7, 0x8c, 0xdc, 0xa6, 0xc5,
+	0x2e, 0xd1, 0x88, 0x99, 0x59, 0x66, 0xc4, 0xce, 0xb0, 0x62, 0xb5, 0xa4, 0x1d, 0xaf, 0x62, 0x98,
+	0x75, 0xa2, 0x72, 0x82, 0xb4, 0xe2, 0x10, 0x75, 0xec, 0x4e, 0xd2, 0xc2, 0xee, 0x36, 0xfd, 0x11,
+	0x94, 0x1b, 0x42, 0x48, 0x20, 0x83, 0xd0, 0x8a, 0x03, 0x17, 0x14, 0x71, 0x00, 0x09, 0x24, 0xfe,
+	0x01, 0xfe, 0x84, 0x39, 0xee, 0x99, 0x43, 0x03, 0xe1, 0xe6, 0xbf, 0x60, 0x8e, 0xa8, 0xaa, 0xfa,
+	0x3b, 0x66, 0x1c, 0x13, 0xe6, 0xe2, 0xae, 0x7a, 0xf5, 0xde, 0xaf, 0xdf, 0xfb, 0xbd, 0xd7, 0x55,
+	0xaf, 0x0c, 0x05, 0x65, 0xaa, 0x3d, 0x99, 0x9a, 0x86, 0x6d, 0xe0, 0xb4, 0x32, 0xd5, 0x9a, 0x8f,
+	0xcf, 0x35, 0xfb, 0xc2, 0x39, 0x7d, 0x32, 0x34, 0x26, 0x4f, 0xcf, 0x8d, 0x73, 0xe3, 0x29, 0x5b,
+	0x3b, 0x75, 0xce, 0xd8, 0x8c, 0x4d, 0xd8, 0x88, 0xdb, 0x48, 0x5f, 0x22, 0x80, 0xce, 0x58, 0x53,
+	0x75, 0xbb, 0xa7, 0x9f, 0x19, 0xf8, 0x21, 0x64, 0x1c, 0x4b, 0x35, 0x1b, 0xa8, 0x8d, 0x76, 0x0a,
+	0x72, 0x7e, 0xee, 0x8a, 0x6c, 0x4e, 0xd8, 0x2f, 0x96, 0x60, 0x7d, 0xc8, 0x74, 0x1b, 0x29, 0xb6,
+	0x0e, 0x73, 0x57, 0xf4, 0x24, 0xc4, 0x7b, 0xe2, 0x4f, 0xa0, 0x30, 0x34, 0x74, 0xfd, 0x44, 0xd3,
+	0xcf, 0x8c, 0x46, 0xba, 0x8d, 0x76, 0x4a, 0xb2, 0xf4, 0xda, 0x15, 0xd7, 0xfe, 0xee, 0x8a, 0x69,
+	0xa2, 0xfc, 0x64, 0xee, 0x8a, 0xd5, 0x60, 0xfd, 0x1b, 0xc6, 0x44, 0xb3, 0xd5, 0xc9, 0xd4, 0xbe,
+	0x22, 0x79, 0x2a, 0x64, 0x2e, 0x50, 0x80, 0x0b, 0xc5, 0x03, 0xc8, 0x2c, 0x06, 0xb8, 0x50, 0x16,
+	0x00, 0x5c, 0x28, 0x0c, 0x40, 0xfa, 0x3d, 0x82, 0xe2, 0xa1, 0x73, 0x3a, 0xd6, 0x86, 0x8a, 0xad,
+	0x19, 0x3a, 0x7e, 0x04, 0x69, 0x47, 0x1b, 0x79, 0x21, 0x35, 0x6e, 0x5c, 0x31, 0x7d, 0xdc, 0xdb,
+	0x9b, 0xbb, 0x62, 0xd9, 0xd1, 0x46, 0x11, 0x00, 0xaa, 0x84, 0xbf, 0x0e, 0x99, 0x91, 0x62, 0x2b,
+	0x2c, 0xbe, 0x92, 0x5c, 0x8d, 0xbf, 0x97, 0x2d, 0x11, 0xf6, 0x8b, 0x5f, 0x40, 0x26, 0x88, 0xb0,
+	0xf8, 0x6c, 0xe3, 0x09, 0xcd, 0x42, 0xc8, 0xa3, 0x8c, 0xe7, 0xae, 0x58, 0x49, 0x78, 0xc8, 0x0c,
+	0xa4, 0x57, 0x90, 0xed, 0x9a, 0xa6, 0x61, 0x52, 0xaa, 0x87, 0xc6, 0x48, 0x65, 0x7e, 0x95, 0x39,
+	0xd5, 0x74, 0x4e, 0xd8, 0x2f, 0x7e, 0x0f, 0x72, 0x13, 0xd5, 0xb2, 0x94, 0x73, 0xd5, 0xe3, 0xba,
+	0x38, 0x77, 0x45, 0x5f, 0x44, 0xfc, 0x81, 0xf4, 0x2b, 0x04, 0xb9, 0x8e, 0x31, 0x99, 0x28, 0xfa,
+	0x08, 0x3f, 0x84, 0x94, 0x17, 0x66, 0x59, 0x2e, 0xdd, 0xb8, 0x62, 0x8a, 0x45, 0x99, 0xd2, 0x46,
+	0x24, 0xa5, 0x8d, 0xf0, 0x73, 0x58, 0x9f, 0xa8, 0xf6, 0x85, 0x31, 0x62, 0x78, 0x15, 0xcf, 0xe5,
+	0xcf, 0x98, 0xe8, 0xe8, 0x6a, 0xaa, 0xf2, 0x64, 0x72, 0x15, 0xe2, 0x3d, 0xf1, 0x63, 0x58, 0x9f,
+	0x2a, 0xa6, 0x32, 0xb1, 0xbc, 0x4c, 0xd6, 0xe3, 0x84, 0x78, 0x8b, 0xc4, 0x7b, 0x4a, 0x7f, 0x40,
+	0x90, 0x25, 0xea, 0x74, 0x7c, 0x85, 0xdf, 0x8f, 0xf8, 0xb2, 0x15, 0xf8, 0x52, 0x8a, 0x11, 0x4e,
+	0xbd, 0xfa, 0x16, 0x64, 0x55, 0xca, 0x06, 0x73, 0xaa, 0xf8, 0x0c, 0x98, 0x53, 0x8c, 0x1f, 0xb9,
+	0x3a, 0x77, 0xc5, 0x0d, 0xb6, 0x18, 0xb1, 0xe1, 0xda, 0xf8, 0x05, 0xac, 0x9b, 0xaa, 0xe5, 0x8c,
+	0x6d, 0xcf, 0x2f, 0x31, 0xee, 0x97, 0xc0, 0x17, 0x23, 0x76, 0x9e, 0xba, 0xf4, 0x33, 0x04, 0x15,
+	0x56, 0x1b, 0xd6, 0x05, 0x51, 0x7f, 0xec, 0xa8, 0x96, 0x4d, 0x99, 0xa6, 0xa5, 0xa3, 0xab, 0xe3,
+	0x06, 0x0a, 0x99, 0xf6, 0x44, 0xc4, 0x1f, 0xdc, 0xbd, 0x32, 0xda, 0xbc, 0xdc, 0xd2, 0x0c, 0xab,
+	0x12, 0x96, 0x1b, 0x95, 0xb2, 0x22, 0x93, 0x66, 0x08, 0x36, 0x02, 0x27, 0xac, 0xa9, 0xa1, 0x5b,
+	0x6a, 0x48, 0x04, 0x5a, 0x89, 0x88, 0xef, 0x06, 0x44, 0x70, 0x02, 0x31, 0xb3, 0x0b, 0xc1, 0x9d,
+	0xb1, 0x2d, 0xd7, 0xde, 0xca, 0xc8, 0x06, 0x94, 0x63, 0xea, 0xd2, 0x2f, 0x10, 0x08, 0xb2, 0x69,
+	0x28, 0xa3, 0xa1, 0x62, 0xd9, 0x3e, 0x49, 0x3b, 0x90, 0xf7, 0x88, 0xb0, 0x1a, 0xa8, 0x9d, 0xde,
+	0x29, 0xc8, 0xa5, 0xb9, 0x2b, 0x06, 0x32, 0x12, 0x8c, 0xfe, 0x9f, 0x3c, 0xfd, 0x06, 0xc1, 0x66,
+	0xc4, 0x93, 0xfb, 0x31, 0x25, 0x27, 0x98, 0xaa, 0x31, 0xbb, 0x28, 0xfc, 0x72, 0xae, 0x36, 0x61,
+	0x23, 0x61, 0x20, 0x7d, 0x0e, 0xf8, 0x58, 0xb7, 0x9c, 0x53, 0x6b, 0x68, 0x6a, 0xa7, 0xea, 0x8a,
+	0x35, 0xe5, 0xef, 0xb6, 0xa9, 0x45, 0xbb, 0xad, 0xf4, 0x5b, 0x04, 0xd5, 0x18, 0xf6, 0xfd, 0x08,
+	0xd8, 0x4b, 0x10, 0xb0, 0xc5, 0xec, 0xe2, 0x2f, 0x58, 0x4e, 0x41, 0x15, 0x36, 0x6f, 0x99, 0x48,
+	0xdf, 0x84, 0xcd, 0x3d, 0xcd, 0xa2, 0x3b, 0xb8, 0x3a, 0x0c, 0x4a, 0xe6, 0xad, 0x47, 0x89, 0xf4,
+	0x05, 0x02, 0x1c, 0xb5, 0xb9, 0x5f, 0x6c, 0x9d, 0x44, 0x6c, 0x75, 0x66, 0x17, 0xc3, 0x5f, 0x1e,
+	0x1a, 0x06, 0x21, 0x69, 0x21, 0xbd, 0x84, 0x8d, 0x43, 0x53, 0xb5, 0x54, 0x7d, 0xb8, 0x62, 0x6e,
+	0xa5, 0x5f, 0x23, 0x10, 0x42, 0xd3, 0xfb, 0x85, 0xb7, 0x9b, 0x08, 0xaf, 0xca, 0xbf, 0xf2, 0x10,
+	0x7d, 0x79, 0x70, 0x7f, 0xa5, 0x1b, 0x5f, 0xcc, 0x00, 0x7f, 0x1f, 0xf2, 0x53, 0x4f, 0xc2, 0xbe,
+	0xe9, 0xe2, 0xb3, 0xaf, 0x2e, 0xc0, 0x0d, 0xa6, 0x5d, 0xdd, 0x36, 0xaf, 0xf8, 0x67, 0xef, 0x9b,
+	0x91, 0x60, 0xd4, 0x7c, 0x05, 0xe5, 0x98, 0x22, 0x16, 0x20, 0xfd, 0x23, 0xf5, 0x8a, 0x53, 0x44,
+	0xe8, 0x10, 0xbf, 0x07, 0xd9, 0x4b, 0x65, 0xec, 0xa8, 0x5e, 0x10, 0xc9, 0x33, 0x93, 0xf0, 0xd5,
+	0x8f, 0x52, 0x2f, 0x91, 0xf4, 0x31, 0xd4, 0x7c, 0xb4, 0x81, 0xad, 0xd8, 0xd6, 0x8a, 0xdc, 0xff,
+	0x0e, 0x41, 0x3d, 0x61, 0x7f, 0xbf, 0x04, 0x7c, 0x9a, 0x48, 0x40, 0x23, 0x46, 0x94, 0xff, 0x8a,
+	0xe5, 0x59, 0xb0, 0xa0, 0xba, 0xc0, 0x08, 0x7f, 0x00, 0x45, 0xdd, 0x99, 0x9c, 0xf0, 0x0e, 0xca,
+	0xf2, 0x8e, 0xcd, 0x8d, 0xb9, 0x2b, 0x46, 0xc5, 0x04, 0x74, 0x67, 0xc2, 0xe9, 0xb2, 0xf0, 0x23,
+	0x28, 0xd0, 0x25, 0xfa, 0x29, 0x59, 0xcc, 0xa7, 0xb2, 0x5c, 0x9e, 0xbb, 0x62, 0x28, 0x24, 0x79,
+	0xdd, 0x99, 0x1c, 0xd3, 0x91, 0xf4, 0x02, 0x2a, 0xfb, 0x9a, 0x65, 0x1b, 0xe6, 0xd5, 0x8a, 0x34,
+	0xd2, 0x73, 0x2a, 0xb0, 0x7c, 0x17, 0xe7, 0x54, 0x08, 0xbe, 0x9c, 0xba, 0x1f, 0x42, 0x39, 0xa6,
+	0x8e, 0xbf, 0x07, 0xa5, 0x69, 0xd8, 0xe5, 0x59, 0x5e, 0x09, 0x0b, 0xe1, 0x01, 0xc8, 0x17, 0xe4,
+	0xda, 0x6b, 0x57, 0x44, 0xb4, 0xf9, 0x88, 0x6a, 0x93, 0xd8, 0x8c, 0xd6, 0x5b, 0x00, 0x3e, 0x31,
+	0x2e, 0xd5, 0xff, 0xa1, 0xde, 0x12, 0xf6, 0xef, 0xa2, 0xde, 0x92, 0xaf, 0x58, 0x4e, 0x5a, 0x1d,
+	0xaa, 0x0b, 0x8c, 0xe8, 0x39, 0xd6, 0xf1, 0x4f, 0x6e, 0x1e, 0x29, 0xdb, 0xae, 0x42, 0xd9, 0xbb,
+	0xd8, 0xae, 0x22, 0xe8, 0xcb, 0x1d, 0xff, 0x08, 0x2a, 0x71, 0xfd, 0xbb, 0x77, 0x20, 0x52, 0x19,
+	0x8a, 0x6c, 0x3f, 0xf1, 0x22, 0xfb, 0x39, 0x82, 0x12, 0x9f, 0xdf, 0x2f, 0xaa, 0x8f, 0x13, 0x51,
+	0xf1, 0xfd, 0xcb, 0x43, 0xbe, 0xcb, 0xa7, 0x0f, 0xa1, 0x2e, 0xfe, 0x00, 0xb2, 0xba, 0x31, 0x52,
+	0xfd, 0xaa, 0xe5, 0x58, 0x7d, 0xda, 0xfe, 0x73, 0xac, 0xc2, 0xdc, 0x15, 0xb9, 0x06, 0xe1, 0x0f,
+	0xda, 0xbf, 0xdb, 0x86, 0xad, 0x8c, 0xad, 0x5b, 0xaf, 0x3f, 0x62, 0x62, 0xde, 0xbf, 0x73, 0x15,
+	0xe2, 0x3d, 0xa5, 0x7f, 0xa4, 0x00, 0x42, 0x54, 0xbf, 0xe5, 0x42, 0xff, 0xb5, 0xe5, 0xa2, 0x87,
+	0xb6, 0xae, 0x4c, 0xd4, 0x68, 0x47, 0x42, 0xe7, 0x84, 0xfd, 0xd2, 0xcf, 0xe1, 0x52, 0x35, 0x2d,
+	0xcd, 0xd0, 0x1b, 0xe9, 0xf0, 0x73, 0xf0, 0x44, 0xc4, 0x1f, 0x24, 0xb7, 0xb3, 0xcc, 0x8a, 0xdb,
+	0x59, 0xf6, 0xad, 0xdb, 0x19, 0x7e, 0x0e, 0x25, 0x06, 0xe3, 0x17, 0xc3, 0x3a, 0x53, 0x17, 0xe8,
+	0x17, 0x1e, 0x95, 0x13, 0xfa, 0x32, 0xbf, 0x86, 0xe8, 0xcd, 0xd5, 0x99, 0xda, 0xda, 0x44, 0x6d,
+	0xe4, 0x98, 0x3a, 0x23, 0x8b, 0x4b, 0x88, 0xf7, 0xc4, 0xcf, 0xe9, 0x95, 0xcb, 0x36, 0xb5, 0xa1,
+	0xd5, 0xc8, 0x33, 0x8a, 0x4b, 0xfe, 0x15, 0x89, 0xca, 0xfc, 0x0b, 0x18, 0x9b, 0x10, 0x7f, 0x20,
+	0xfd, 0x19, 0x41, 0xce, 0xd3, 0xa0, 0x25, 0xaa, 0xe9, 0xb6, 0x6a, 0x5e, 0x2a, 0x7c, 0xbb, 0x40,
+	0xbc, 0x44, 0x7d, 0x19, 0x09, 0x46, 0xf8, 0x25, 0x64, 0x69, 0x7d, 0xd0, 0x5c, 0xd2, 0xf4, 0x6f,
+	0x47, 0x5f, 0xf4, 0xa4, 0x47, 0x57, 0xf8, 0x69, 0xcb, 0xca, 0x80, 0x69, 0x12, 0xfe, 0x68, 0xbe,
+	0x04, 0x08, 0xd7, 0x17, 0x1c, 0xb2, 0xb5, 0xe8, 0x21, 0x8b, 0xa2, 0x67, 0xea, 0x4f, 0x53, 0x00,
+	0x61, 0xb9, 0xf8, 0x94, 0xfb, 0x55, 0x18, 0xa3, 0x9c, 0x09, 0x19, 0xe5, 0xb4, 0x78, 0xac, 0x64,
+	0x42, 0x53, 0x2b, 0x26, 0x34, 0xbd, 0x5a, 0x42, 0x33, 0x77, 0x49, 0x68, 0x24, 0x59, 0xd9, 0xbb,
+	0x26, 0xeb, 0xd1, 0x9f, 0xd2, 0x00, 0xe1, 0x8d, 0x17, 0x4b, 0x90, 0x3b, 0x3c, 0x96, 0x5f, 0xf5,
+	0x06, 0xfb, 0xc2, 0x5a, 0xb3, 0x3e, 0xbb, 0x6e, 0x6f, 0x86, 0x8b, 0xde, 0x9d, 0x08, 0xbf, 0x0f,
+	0x05, 0x99, 0x1c, 0xec, 0xee, 0x75, 0x76, 0x07, 0x47, 0x02, 0x6a, 0x6e, 0xcf, 0xae, 0xdb, 0xd5,
+	0x50, 0x2b, 0xb8, 0x0d, 0xe0, 0x47, 0x50, 0x3c, 0xee, 0x0f, 0x8e, 0xe5, 0x41, 0x87, 0xf4, 0xe4,
+	0xae, 0x90, 0x6a, 0x3e, 0x98, 0x5d, 0xb7, 0xeb, 0xa1, 0x66, 0xa4, 0x69, 0xc6, 0x3b, 0x00, 0x7b,
+	0xbd, 0x41, 0xe7, 0xa0, 0xdf, 0xef, 0x76, 0x8e, 0x84, 0x74, 0xb3, 0x31, 0xbb, 0x6e, 0xd7, 0x42,
+	0xd5, 0xb0, 0x09, 0xc5, 0x5f, 0x83, 0xfc, 0x21, 0xe9, 0x0e, 0xba, 0xfd, 0x4e, 0x57, 0xc8, 0x34,
+	0xb7, 0x66, 0xd7, 0x6d, 0x1c, 0x71, 0xd1, 0xeb, 0x24, 0xf0, 0x53, 0xa8, 0xf8, 0x5a, 0x27, 0x83,
+	0xa3, 0xdd, 0xa3, 0x81, 0x90, 0x6d, 0x7e, 0x65, 0x76, 0xdd, 0xde, 0xbe, 0xad, 0xcb, 0xba, 0x0e,
+	0x1a, 0xf8, 0x7e, 0x6f, 0x70, 0x74, 0x40, 0x3e, 0x17, 0xd6, 0x93, 0x81, 0x7b, 0xe7, 0x05, 0x05,
+	0xf5, 0x74, 0x4e, 0x48, 0xf7, 0xb3, 0x83, 0x1f, 0x74, 0x85, 0x5c, 0x12, 0x34, 0x76, 0xb4, 0x50,
+	0x5f, 0x3b, 0xfb, 0xbb, 0xfd, 0x7e, 0xf7, 0xd5, 0x40, 0xc8, 0x27, 0x7d, 0x0d, 0xf2, 0xf6, 0x10,
+	0x32, 0xbd, 0xfe, 0xa7, 0x07, 0x42, 0xa1, 0x89, 0x67, 0xd7, 0xed, 0x4a, 0xa8, 0x41, 0x4b, 0xb3,
+	0x99, 0xf9, 0xe5, 0x1f, 0x5b, 0x6b, 0xcf, 0xde, 0x64, 0x00, 0x3a, 0xaa, 0x6e, 0x9b, 0xda, 0x99,
+	0x73, 0xae, 0xe2, 0x0f, 0x21, 0xe7, 0x67, 0xa3, 0x1a, 0xbf, 0xde, 0xb2, 0x0d, 0xbe, 0x59, 0x8b,
+	0x0b, 0xf9, 0x2e, 0x2f, 0xad, 0xe1, 0xef, 0x40, 0x21, 0xcc, 0x4f, 0x3d, 0x79, 0xdd, 0xe3, 0xb6,
+	0x5b, 0x49, 0x71, 0x60, 0x2d, 0x43, 0x31, 0x9a, 0xb3, 0xed, 0xdb, 0xb7, 0x25, 0x8e, 0xd0, 0xb8,
+	0xbd, 0x10, 0x60, 0x7c, 0x02, 0x10, 0x49, 0xe6, 0xd6, 0xad, 0x4b, 0x09, 0x47, 0xd8, 0xbe, 0x25,
+	0x0f, 0x00, 0xbe, 0x0d, 0xf9, 0x20, 0xcb, 0xb5, 0x44, 0x73, 0xce, 0x8d, 0xeb, 0x09, 0x69, 0x60,
+	0xba, 0x0f, 0xe5, 0x78, 0xd2, 0x1f, 0x2c, 0xea, 0x59, 0x39, 0x48, 0x73, 0xd1, 0x52, 0x80, 0xf4,
+	0x21, 0xe4, 0xfc, 0xa2, 0xa8, 0xc6, 0xfb, 0x90, 0x28, 0xff, 0x89, 0x46, 0x91, 0x7b, 0x10, 0xaf,
+	0x90, 0x07, 0x8b, 0xba, 0x98, 0xa8, 0x07, 0x0b, 0x7b, 0x28, 0x4e, 0x43, 0x50, 0x40, 0xb5, 0x44,
+	0x33, 0x11, 0xa5, 0x21, 0xd9, 0xc0, 0x48, 0x6b, 0xf8, 0x31, 0x64, 0xd8, 0xbf, 0x8a, 0x42, 0xe4,
+	0xb4, 0xe6, 0x26, 0x9b, 0x11, 0x89, 0xaf, 0x2e, 0x3f, 0x7c, 0xf3, 0xaf, 0x16, 0xfa, 0xcb, 0x4d,
+	0x0b, 0xfd, 0xed, 0xa6, 0x85, 0x5e, 0xdf, 0xb4, 0xd0, 0x97, 0x37, 0x2d, 0xf4, 0xcf, 0x9b, 0x16,
+	0xfa, 0xe2, 0xdf, 0xad, 0xb5, 0xd3, 0x75, 0xf6, 0x9f, 0xe9, 0xf3, 0xff, 0x0c, 0x00, 0x85, 0xbe,
+	0xa5, 0x60, 0x74, 0x15, 0x00, 0x00,
 }
@@ -158,4 +158,49 @@ func TestInfoAPI(t *testing.T) {
 	api := newAPIExecutor(node, "test")
 	resp := api.Info(context.Background(), &InfoRequest{})
 	assert.Nil(t, resp.Error)
+	assert.Equal(t, uint32(len(resp.Result.Nodes)), resp.Result.Totals.NumNodes)
+}
+
+func TestInfoTotals(t *testing.T) {
+	nodes := []*NodeResult{
+		{
+			UID:         "1",
+			NumClients:  2,
+			NumUsers:    2,
+			NumChannels: 3,
+			Metrics: &Metrics{
+				Interval: 60,
+				Items: map[string]float64{
+					"centrifuge.node.num_clients":                             2,
+					"centrifuge.node.messages_sent_count.type.publication":    10,
+					"centrifuge.client.command_duration_seconds.quantile.0.5": 0.1,
+				},
+			},
+		},
+		{
+			UID:         "2",
+			NumClients:  5,
+			NumUsers:    4,
+			NumChannels: 1,
+			Metrics: &Metrics{
+				Interval: 60,
+				Items: map[string]float64{
+					"centrifuge.node.num_clients":                             5,
+					"centrifuge.node.messages_sent_count.type.publication":    7,
+					"centrifuge.client.command_duration_seconds.quantile.0.5": 0.2,
+				},
+			},
+		},
+		{UID: "3", NumClients: 1},
+	}
+	totals := infoTotals(nodes)
+	assert.Equal(t, uint32(3), totals.NumNodes)
+	assert.Equal(t, uint32(8), totals.NumClients)
+	assert.Equal(t, uint32(6), totals.NumUsers)
+	assert.Equal(t, uint32(4), totals.NumChannels)
+	assert.Equal(t, float64(60), totals.Metrics.Interval)
+	assert.Equal(t, map[string]float64{
+		"centrifuge.node.num_clients":                          7,
+		"centrifuge.node.messages_sent_count.type.publication": 17,
+	}, totals.Metrics.Items)
 }
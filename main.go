@@ -64,7 +64,7 @@ func main() {
 				"join_leave", "presence", "history_recover", "history_size", "history_lifetime",
 				"client_insecure", "api_key", "api_insecure", "admin", "admin_password", "admin_secret",
 				"admin_insecure", "redis_host", "redis_port", "redis_url", "redis_tls", "redis_tls_skip_verify",
-				"port", "internal_port", "tls", "tls_cert", "tls_key",
+				"nats_url", "port", "internal_port", "tls", "tls_cert", "tls_key",
 			}
 			for _, env := range bindEnvs {
 				viper.BindEnv(env)
@@ -75,7 +75,8 @@ func main() {
 				"client_insecure", "admin_insecure", "api_insecure", "port",
 				"address", "tls", "tls_cert", "tls_key", "internal_port", "prometheus", "health",
 				"redis_host", "redis_port", "redis_password", "redis_db", "redis_url",
-				"redis_tls", "redis_tls_skip_verify", "redis_master_name", "redis_sentinels", "grpc_api",
+				"redis_tls", "redis_tls_skip_verify", "redis_master_name", "redis_sentinels", "nats_url",
+				"grpc_api",
 			}
 			for _, flag := range bindPFlags {
 				viper.BindPFlag(flag, cmd.Flags().Lookup(flag))
@@ -140,6 +141,8 @@ func main() {
 				e, err = memoryEngine(node)
 			} else if engineName == "redis" {
 				e, err = redisEngine(node)
+			} else if engineName == "nats" {
+				e, err = natsEngine(node)
 			} else {
 				log.Fatal().Msgf("Unknown engine: %s", engineName)
 			}
@@ -226,7 +229,7 @@ func main() {
 	}
 
 	rootCmd.Flags().StringVarP(&configFile, "config", "c", "config.json", "path to config file")
-	rootCmd.Flags().StringP("engine", "e", "memory", "engine to use: memory or redis")
+	rootCmd.Flags().StringP("engine", "e", "memory", "engine to use: memory, redis or nats")
 	rootCmd.Flags().StringP("log_level", "", "info", "set the log level: debug, info, error, fatal or none")
 	rootCmd.Flags().StringP("log_file", "", "", "optional log file - if not specified logs go to STDOUT")
 	rootCmd.Flags().StringP("pid_file", "", "", "optional path to create PID file")
@@ -260,6 +263,7 @@ func main() {
 	rootCmd.Flags().BoolP("redis_tls_skip_verify", "", false, "disable Redis TLS host verification")
 	rootCmd.Flags().StringP("redis_master_name", "", "", "name of Redis master Sentinel monitors (Redis engine)")
 	rootCmd.Flags().StringP("redis_sentinels", "", "", "comma-separated list of Sentinel addresses (Redis engine)")
+	rootCmd.Flags().StringP("nats_url", "", "", "NATS server URL in format nats://[user:password@]host:port (NATS engine)")
 
 	viper.SetEnvPrefix("centrifugo")
 
@@ -313,13 +317,25 @@ var configDefaults = map[string]interface{}{
 	"engine":                               "memory",
 	"name":                                 "",
 	"secret":                               "",
+	"control_channel_name":                 "control",
 	"publish":                              false,
 	"subscribe_to_publish":                 false,
+	"server_only_publish":                  false,
 	"anonymous":                            false,
 	"presence":                             false,
 	"history_size":                         0,
 	"history_lifetime":                     0,
 	"history_recover":                      false,
+	"history_omit_info":                    false,
+	"history_compact_by_key":               false,
+	"history_reset_notify":                 false,
+	"history_meta_ttl":                     0,
+	"local_only":                           false,
+	"priority":                             0,
+	"join_leave_throttle":                  0,
+	"dedup_window":                         0,
+	"message_max_size":                     0,
+	"acknowledge_delivery":                 false,
 	"namespaces":                           "",
 	"node_info_metrics_aggregate_interval": 60,
 	"client_ping_interval":                 25,
@@ -367,6 +383,9 @@ var configDefaults = map[string]interface{}{
 	"redis_write_timeout":                  1,
 	"redis_idle_timeout":                   0,
 	"redis_pubsub_num_workers":             0,
+	"nats_url":                             "",
+	"nats_prefix":                          "centrifugo",
+	"nats_timeout":                         1,
 	"grpc_api":                             false,
 	"grpc_api_port":                        10000,
 	"shutdown_timeout":                     30,
@@ -442,6 +461,12 @@ func handleSignals(n *centrifuge.Node, httpServers []*http.Server, grpcAPIServer
 				}
 			}
 			cfg := nodeConfig()
+			engineConfig, err := reloadEngineConfig()
+			if err != nil {
+				log.Error().Msgf("error reloading engine configuration: %v", err)
+				continue
+			}
+			cfg.EngineConfig = engineConfig
 			if err := n.Reload(*cfg); err != nil {
 				log.Error().Msgf("error reloading: %v", err)
 				continue
@@ -782,15 +807,27 @@ func nodeConfig() *centrifuge.Config {
 	cfg.Version = VERSION
 	cfg.Name = applicationName()
 	cfg.Secret = v.GetString("secret")
+	cfg.ControlChannelName = v.GetString("control_channel_name")
 
 	cfg.Publish = v.GetBool("publish")
 	cfg.SubscribeToPublish = v.GetBool("subscribe_to_publish")
+	cfg.ServerOnlyPublish = v.GetBool("server_only_publish")
 	cfg.Anonymous = v.GetBool("anonymous")
 	cfg.Presence = v.GetBool("presence")
 	cfg.JoinLeave = v.GetBool("join_leave")
 	cfg.HistorySize = v.GetInt("history_size")
 	cfg.HistoryLifetime = v.GetInt("history_lifetime")
 	cfg.HistoryRecover = v.GetBool("history_recover")
+	cfg.HistoryOmitInfo = v.GetBool("history_omit_info")
+	cfg.HistoryCompactByKey = v.GetBool("history_compact_by_key")
+	cfg.HistoryResetNotify = v.GetBool("history_reset_notify")
+	cfg.HistoryMetaTTL = v.GetInt("history_meta_ttl")
+	cfg.LocalOnly = v.GetBool("local_only")
+	cfg.Priority = v.GetInt("priority")
+	cfg.JoinLeaveThrottle = v.GetInt("join_leave_throttle")
+	cfg.DedupWindow = v.GetInt("dedup_window")
+	cfg.MessageMaxSize = v.GetInt("message_max_size")
+	cfg.AcknowledgeDelivery = v.GetBool("acknowledge_delivery")
 	cfg.Namespaces = namespacesFromConfig(v)
 
 	cfg.ChannelMaxLength = v.GetInt("channel_max_length")
@@ -893,10 +930,41 @@ func redisEngine(n *centrifuge.Node) (centrifuge.Engine, error) {
 	return centrifuge.NewRedisEngine(n, *c)
 }
 
+func natsEngine(n *centrifuge.Node) (centrifuge.Engine, error) {
+	c, err := natsEngineConfig()
+	if err != nil {
+		return nil, err
+	}
+	return centrifuge.NewNatsEngine(n, *c)
+}
+
+// reloadEngineConfig returns engine configuration to apply to running
+// engine on configuration reload.
+func reloadEngineConfig() (interface{}, error) {
+	switch viper.GetString("engine") {
+	case "memory":
+		return memoryEngineConfig()
+	case "redis":
+		return redisEngineConfig()
+	case "nats":
+		return natsEngineConfig()
+	}
+	return nil, nil
+}
+
 func memoryEngineConfig() (*centrifuge.MemoryEngineConfig, error) {
 	return &centrifuge.MemoryEngineConfig{}, nil
 }
 
+func natsEngineConfig() (*centrifuge.NatsEngineConfig, error) {
+	v := viper.GetViper()
+	return &centrifuge.NatsEngineConfig{
+		URL:     v.GetString("nats_url"),
+		Prefix:  v.GetString("nats_prefix"),
+		Timeout: time.Duration(v.GetInt("nats_timeout")) * time.Second,
+	}, nil
+}
+
 func redisEngineConfig() (*centrifuge.RedisEngineConfig, error) {
 	v := viper.GetViper()
 